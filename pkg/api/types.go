@@ -0,0 +1,277 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import "time"
+
+// Time is a versioned wrapper around time.Time, matching the zero-value and
+// JSON-marshaling behavior the rest of the API relies on.
+type Time struct {
+	time.Time
+}
+
+// Before reports whether t occurred before u.
+func (t Time) Before(u Time) bool {
+	return t.Time.Before(u.Time)
+}
+
+// ObjectMeta is embedded in every API object; it holds the identity, labels,
+// and annotations common to all of them.
+type ObjectMeta struct {
+	Name              string
+	GenerateName      string
+	Namespace         string
+	Labels            map[string]string
+	Annotations       map[string]string
+	ResourceVersion   string
+	CreationTimestamp Time
+}
+
+// ObjectReference is a cheap, serializable reference to another API object.
+type ObjectReference struct {
+	Kind      string
+	Namespace string
+	Name      string
+	UID       string
+}
+
+// GetReference returns an ObjectReference to the given ReplicationController.
+func GetReference(controller *ReplicationController) (*ObjectReference, error) {
+	return &ObjectReference{
+		Kind:      "ReplicationController",
+		Namespace: controller.Namespace,
+		Name:      controller.Name,
+	}, nil
+}
+
+// PodPhase is a label for the condition of a pod at the current time.
+type PodPhase string
+
+const (
+	PodPending   PodPhase = "Pending"
+	PodRunning   PodPhase = "Running"
+	PodSucceeded PodPhase = "Succeeded"
+	PodFailed    PodPhase = "Failed"
+	PodUnknown   PodPhase = "Unknown"
+)
+
+// RestartPolicy describes how the kubelet should restart a pod's containers.
+type RestartPolicy string
+
+// EnvVar represents an environment variable present in a container.
+type EnvVar struct {
+	Name  string
+	Value string
+}
+
+// ContainerPort represents a port to expose from a container.
+type ContainerPort struct {
+	Name          string
+	ContainerPort int
+}
+
+// ResourceRequirements describes the compute resource requirements of a container.
+type ResourceRequirements struct {
+	Limits   map[string]string
+	Requests map[string]string
+}
+
+// Container describes a single application container run within a pod.
+type Container struct {
+	Name      string
+	Image     string
+	Ports     []ContainerPort
+	Env       []EnvVar
+	Resources ResourceRequirements
+}
+
+// Volume represents a named volume available for a pod's containers to mount.
+type Volume struct {
+	Name string
+}
+
+// PodSpec is a description of a pod.
+type PodSpec struct {
+	Volumes       []Volume
+	Containers    []Container
+	RestartPolicy RestartPolicy
+	// Host is set once the pod is assigned to a node.
+	Host         string
+	NodeSelector map[string]string
+}
+
+const (
+	PodReady      = "Ready"
+	ConditionTrue = "True"
+)
+
+// PodCondition records the observed state of an aspect of a pod, eg readiness.
+type PodCondition struct {
+	Type   string
+	Status string
+}
+
+// PodStatus represents the current status of a pod.
+type PodStatus struct {
+	Phase      PodPhase
+	Conditions []PodCondition
+	PodIP      string
+}
+
+// IsPodReady returns true if pod's PodReady condition is true.
+func IsPodReady(pod *Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == PodReady {
+			return c.Status == ConditionTrue
+		}
+	}
+	return false
+}
+
+// Pod is a collection of containers scheduled onto a single host.
+type Pod struct {
+	ObjectMeta
+	Spec   PodSpec
+	Status PodStatus
+}
+
+// PodTemplateSpec describes the pods that will be created from a template.
+type PodTemplateSpec struct {
+	ObjectMeta
+	Spec PodSpec
+}
+
+// ReplicationControllerUpdateStrategyType enumerates how the rc manager
+// reconciles a running pod with a changed Template.
+type ReplicationControllerUpdateStrategyType string
+
+const (
+	// RecreateRCUpdateStrategy deletes and recreates the pod, today's only behavior.
+	RecreateRCUpdateStrategy ReplicationControllerUpdateStrategyType = "Recreate"
+	// InPlaceIfPossibleRCUpdateStrategy patches the pod in place when only mutable
+	// fields changed, and falls back to delete+create otherwise.
+	InPlaceIfPossibleRCUpdateStrategy ReplicationControllerUpdateStrategyType = "InPlaceIfPossible"
+	// InPlaceOnlyRCUpdateStrategy patches the pod in place and records a
+	// failedInPlaceUpdate event, without falling back, when immutable fields changed.
+	InPlaceOnlyRCUpdateStrategy ReplicationControllerUpdateStrategyType = "InPlaceOnly"
+)
+
+// ReplicationControllerSpec is the specification of a replication controller.
+type ReplicationControllerSpec struct {
+	Replicas int
+	Selector map[string]string
+	Template *PodTemplateSpec
+
+	// PodLifecycleGate opts an rc into waiting for external readiness gates to
+	// acknowledge a pod's pending lifecycle operation (eg scale-down) before the
+	// rc manager deletes it. See pkg/controller's PodOperatingAnnotation.
+	PodLifecycleGate bool
+	// ScaleDownGracePeriodSeconds bounds how long the rc manager waits for
+	// readiness gates to ack a pending drain before deleting the pod regardless.
+	// Only consulted when PodLifecycleGate is set; defaults to
+	// controller.DefaultScaleDownGracePeriod when nil.
+	ScaleDownGracePeriodSeconds *int64
+
+	// UpdateStrategy selects how the rc manager reconciles a running pod with a
+	// changed Template; defaults to RecreateRCUpdateStrategy.
+	UpdateStrategy ReplicationControllerUpdateStrategyType
+
+	// ScaleInPolicy names the registered DeletionPolicy the rc manager should use
+	// to order candidates when scaling in; defaults to "LeastReady".
+	ScaleInPolicy string
+}
+
+// ReplicationControllerStatus represents the current status of a replication controller.
+type ReplicationControllerStatus struct {
+	Replicas      int
+	ReadyReplicas int
+}
+
+// ReplicationController represents the configuration of a replication controller.
+type ReplicationController struct {
+	ObjectMeta
+	Spec   ReplicationControllerSpec
+	Status ReplicationControllerStatus
+}
+
+// EndpointAddress is a reachable address on an EndpointSubset.
+type EndpointAddress struct {
+	IP string
+}
+
+// EndpointSubset is a group of addresses sharing the same set of ports.
+type EndpointSubset struct {
+	Addresses []EndpointAddress
+}
+
+// Endpoints is a collection of endpoints that implement a Service.
+type Endpoints struct {
+	ObjectMeta
+	Subsets []EndpointSubset
+}
+
+// EndpointsList is a list of Endpoints.
+type EndpointsList struct {
+	Items []Endpoints
+}
+
+// scheme implements the handful of conversions pkg/controller relies on.
+type scheme struct{}
+
+// Scheme is the default instance used to convert and copy API objects.
+var Scheme = &scheme{}
+
+// Convert copies in into out if they're both *PodSpec; this stands in for the
+// real field-by-field versioned conversion.
+func (s *scheme) Convert(in, out interface{}) error {
+	if inSpec, ok := in.(*PodSpec); ok {
+		if outSpec, ok := out.(*PodSpec); ok {
+			*outSpec = *inSpec
+			return nil
+		}
+	}
+	return errUnsupportedConversion
+}
+
+// Copy returns a deep-enough copy of in for the mutations pkg/controller makes.
+func (s *scheme) Copy(in interface{}) (interface{}, error) {
+	if pod, ok := in.(*Pod); ok {
+		out := *pod
+		out.Spec.Containers = append([]Container(nil), pod.Spec.Containers...)
+		out.Labels = copyStringMap(pod.Labels)
+		out.Annotations = copyStringMap(pod.Annotations)
+		return &out, nil
+	}
+	return nil, errUnsupportedConversion
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+var errUnsupportedConversion = errUnsupportedConversionErr{}
+
+type errUnsupportedConversionErr struct{}
+
+func (errUnsupportedConversionErr) Error() string { return "unsupported conversion" }
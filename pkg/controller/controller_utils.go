@@ -19,20 +19,210 @@ package controller
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/wait"
 	"github.com/golang/glog"
+	"sort"
+	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 )
 
 const CreatedByAnnotation = "kubernetes.io/created-by"
 
+const (
+	// PodOperatingAnnotation marks a pod as undergoing a named lifecycle operation
+	// (eg PodOperationScaleDown) on behalf of the rc manager. External controllers
+	// that need to block the operation - traffic drainers, PDB-aware sidecars,
+	// session stickiness managers - watch for this annotation.
+	PodOperatingAnnotation = "kubernetes.io/pod-operating"
+	// PodOperationIDAnnotation carries the ID of the in-flight operation named by
+	// PodOperatingAnnotation, so readiness acks can be matched to the operation
+	// that requested them.
+	PodOperationIDAnnotation = "kubernetes.io/pod-operation-id"
+	// PodOperateReadyAnnotation is set by an external controller, to the operation
+	// ID it is acknowledging, once it no longer needs to block that operation.
+	PodOperateReadyAnnotation = "pod-operate-ready"
+	// PodOperationStartedAnnotation records, in RFC3339, when PodOperatingAnnotation
+	// was set, so isPodDrainComplete can enforce scaleDownGracePeriodSeconds.
+	PodOperationStartedAnnotation = "kubernetes.io/pod-operation-started-at"
+
+	// PodOperationScaleDown is the PodOperatingAnnotation value the rc manager
+	// sets while gracefully removing a pod as part of a scale-down.
+	PodOperationScaleDown = "scale-down"
+
+	// DefaultScaleDownGracePeriod is how long the rc manager waits for readiness
+	// gates to ack a pending drain before deleting the pod regardless, for rcs
+	// that opt into PodLifecycleGate but don't set ScaleDownGracePeriodSeconds.
+	DefaultScaleDownGracePeriod = 30 * time.Second
+
+	// PodTemplateHashAnnotation is set to a hash of controller.Spec.Template on every
+	// pod the rc manager creates or updates in place, so the sync loop can tell
+	// whether a pod is already running the rc's current template.
+	PodTemplateHashAnnotation = "kubernetes.io/pod-template-hash"
+
+	// ReplicaInstanceIDAnnotation records the stable slot-based identity a
+	// ReplicaSlotAllocator assigned a pod, eg "<rc-name>-3", so external systems
+	// (persistent volumes, DNS records) can rely on it across pod recreations.
+	ReplicaInstanceIDAnnotation = "kubernetes.io/replica-instance-id"
+
+	// ToBeDeletedLabel is the annotation (matching the cluster-autoscaler
+	// convention, despite the name) that marks a pod for priority deletion under
+	// LabeledFirstDeletionPolicy.
+	ToBeDeletedLabel = "kubernetes.io/to-be-deleted"
+	// PodDeletionCostAnnotation lets CostAwareDeletionPolicy prefer deleting the
+	// lowest-cost pods first; pods without it are treated as cost 0.
+	PodDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+)
+
+// Names of the built-in DeletionPolicy implementations, valid values for an rc's
+// Spec.ScaleInPolicy.
+const (
+	LeastReadyDeletionPolicy   = "LeastReady"
+	NewestDeletionPolicy       = "Newest"
+	OldestDeletionPolicy       = "Oldest"
+	LabeledFirstDeletionPolicy = "LabeledFirst"
+	CostAwareDeletionPolicy    = "CostAware"
+	SpreadAwareDeletionPolicy  = "SpreadAware"
+)
+
+// errImmutableFieldsChanged is returned by updateReplica when the new template
+// differs from the running pod in fields that can't be patched in place (eg
+// volumes, restart policy, container names or count).
+var errImmutableFieldsChanged = fmt.Errorf("pod template has changes to immutable fields")
+
+// ReplicaSlotAllocator hands out stable, deterministic slot IDs for the replicas of
+// a single rc, so a pod keeps the same name - and ReplicaInstanceIDAnnotation -
+// across recreations instead of getting a fresh GenerateName suffix every time.
+// The zero value is not usable; construct with NewReplicaSlotAllocator.
+type ReplicaSlotAllocator struct {
+	lock sync.Mutex
+	// slots maps rc key -> slot ID -> name of the pod currently occupying it.
+	slots map[string]map[int]string
+}
+
+// NewReplicaSlotAllocator returns an empty ReplicaSlotAllocator.
+func NewReplicaSlotAllocator() *ReplicaSlotAllocator {
+	return &ReplicaSlotAllocator{slots: make(map[string]map[int]string)}
+}
+
+// replicaSlotName returns the stable pod name for the given rc and slot.
+func replicaSlotName(controller *api.ReplicationController, slot int) string {
+	return fmt.Sprintf("%s-%d", controller.Name, slot)
+}
+
+// NextSlot returns the smallest free slot ID for rc, reserving it against
+// duplicate allocation until Reserve or ReleaseSlotID is called.
+func (a *ReplicaSlotAllocator) NextSlot(rc *api.ReplicationController) (int, error) {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return 0, err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	rcSlots := a.slots[rcKey]
+	if rcSlots == nil {
+		rcSlots = make(map[int]string)
+		a.slots[rcKey] = rcSlots
+	}
+	for slot := 0; ; slot++ {
+		if _, taken := rcSlots[slot]; !taken {
+			rcSlots[slot] = ""
+			return slot, nil
+		}
+	}
+}
+
+// Reserve records podName as the confirmed occupant of slot for rc, once its
+// creation has succeeded.
+func (a *ReplicaSlotAllocator) Reserve(rc *api.ReplicationController, slot int, podName string) error {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.slots[rcKey] == nil {
+		a.slots[rcKey] = make(map[int]string)
+	}
+	a.slots[rcKey][slot] = podName
+	return nil
+}
+
+// ReleaseSlot frees the slot occupied by podName for rc so a future replica can
+// reuse it. Call this from the rc manager's delete-watch handler, alongside
+// RCExpectations.DeletionObserved, once a pod is confirmed gone.
+func (a *ReplicaSlotAllocator) ReleaseSlot(rc *api.ReplicationController, podName string) error {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for slot, name := range a.slots[rcKey] {
+		if name == podName {
+			delete(a.slots[rcKey], slot)
+			return nil
+		}
+	}
+	return nil
+}
+
+// ReleaseSlotID frees slot for rc directly, for callers that reserved a slot via
+// NextSlot but never got as far as Reserve-ing a pod name into it - eg
+// createReplica failing before or during its Create call. ReleaseSlot remains
+// the right call once a named pod has actually been confirmed gone.
+func (a *ReplicaSlotAllocator) ReleaseSlotID(rc *api.ReplicationController, slot int) error {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.slots[rcKey], slot)
+	return nil
+}
+
+// AdoptExisting assigns a free slot to each of rc's active pods that predates the
+// slot allocator (ie still named via the legacy GenerateName scheme and missing
+// ReplicaInstanceIDAnnotation), so they keep their existing name - and anything
+// keyed on it - instead of being recreated on the first sync after upgrade. The
+// assignment is persisted onto the pod itself via kubeClient, both to expose it
+// to external systems as ReplicaInstanceIDAnnotation and so a pod already
+// adopted is skipped - rather than handed another fresh slot - on the next call.
+func (a *ReplicaSlotAllocator) AdoptExisting(kubeClient client.Interface, namespace string, rc *api.ReplicationController, pods []*api.Pod) error {
+	for _, pod := range pods {
+		if _, hasSlot := pod.Annotations[ReplicaInstanceIDAnnotation]; hasSlot {
+			continue
+		}
+		slot, err := a.NextSlot(rc)
+		if err != nil {
+			return err
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = make(labels.Set)
+		}
+		pod.Annotations[ReplicaInstanceIDAnnotation] = replicaSlotName(rc, slot)
+		if _, err := kubeClient.Pods(namespace).Update(pod); err != nil {
+			a.ReleaseSlotID(rc, slot)
+			return fmt.Errorf("unable to adopt pod %v into slot %d: %v", pod.Name, slot, err)
+		}
+		if err := a.Reserve(rc, slot, pod.Name); err != nil {
+			return err
+		}
+		glog.V(4).Infof("Adopted pre-slot pod %v into slot %d for controller %v", pod.Name, slot, rc.Name)
+	}
+	return nil
+}
+
 // Expectations are a way for replication controllers to tell the rc manager what they expect. eg:
 //	RCExpectations: {
 //		rc1: expects  2 adds in 2 minutes
@@ -101,40 +291,93 @@ func (r *RCExpectations) SatisfiedExpectations(rc *api.ReplicationController) bo
 }
 
 // setExpectations registers new expectations for the given rc. Forgets existing expectations.
-func (r *RCExpectations) setExpectations(rc *api.ReplicationController, add, del int) error {
+func (r *RCExpectations) setExpectations(rc *api.ReplicationController, add, del, drain, update int) error {
 	rcKey, err := rcKeyFunc(rc)
 	if err != nil {
 		return err
 	}
-	return r.Add(&PodExpectations{add: int64(add), del: int64(del), key: rcKey})
+	return r.Add(&PodExpectations{add: int64(add), del: int64(del), drain: int64(drain), update: int64(update), key: rcKey})
+}
+
+// addExpectations accumulates onto whatever expectations the given rc already
+// has outstanding, rather than replacing them like setExpectations does. Needed
+// for dimensions that get a separate call per pod from inside a loop (eg
+// ExpectDrains from ScaleIn's per-pod DeleteReplica calls): unlike
+// ExpectCreations/ExpectDeletions, which the sync loop calls once per sync with
+// the full diff, each of these calls must add to the running total instead of
+// clobbering whatever the previous call in the same sync just set.
+func (r *RCExpectations) addExpectations(rc *api.ReplicationController, add, del, drain, update int) error {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return err
+	}
+	if podExp, exists, err := r.GetExpectations(rc); err == nil && exists {
+		add += int(podExp.add)
+		del += int(podExp.del)
+		drain += int(podExp.drain)
+		update += int(podExp.update)
+	}
+	return r.Add(&PodExpectations{add: int64(add), del: int64(del), drain: int64(drain), update: int64(update), key: rcKey})
 }
 
 func (r *RCExpectations) ExpectCreations(rc *api.ReplicationController, adds int) error {
-	return r.setExpectations(rc, adds, 0)
+	return r.setExpectations(rc, adds, 0, 0, 0)
 }
 
 func (r *RCExpectations) ExpectDeletions(rc *api.ReplicationController, dels int) error {
-	return r.setExpectations(rc, 0, dels)
+	return r.setExpectations(rc, 0, dels, 0, 0)
+}
+
+// ExpectDrains registers pods that have been marked for a lifecycle operation (eg
+// PodOperationScaleDown) but aren't yet confirmed safe to delete. The rc manager
+// should not busy-loop while a drain is outstanding; DrainObserved, or the rc's
+// scaleDownGracePeriodSeconds elapsing, clears it.
+func (r *RCExpectations) ExpectDrains(rc *api.ReplicationController, drains int) error {
+	return r.addExpectations(rc, 0, 0, drains, 0)
+}
+
+// ExpectUpdates registers pods that updateReplica has patched in place, whose
+// watch event the rc manager hasn't yet observed. Unlike ExpectCreations/
+// ExpectDeletions, reconcilePod calls this once per pod from inside a loop, so
+// it accumulates via addExpectations rather than replacing the rc's other
+// outstanding expectations.
+func (r *RCExpectations) ExpectUpdates(rc *api.ReplicationController, updates int) error {
+	return r.addExpectations(rc, 0, 0, 0, updates)
 }
 
 // Decrements the expectation counts of the given rc.
-func (r *RCExpectations) lowerExpectations(rc *api.ReplicationController, add, del int) {
+func (r *RCExpectations) lowerExpectations(rc *api.ReplicationController, add, del, drain, update int) {
 	if podExp, exists, err := r.GetExpectations(rc); err == nil && exists {
 		if podExp.add > 0 && podExp.del > 0 {
 			glog.V(2).Infof("Controller has both add and del expectations %+v", podExp)
 		}
-		podExp.Seen(int64(add), int64(del))
+		podExp.Seen(int64(add), int64(del), int64(drain), int64(update))
 	}
 }
 
 // CreationObserved atomically decrements the `add` expecation count of the given replication controller.
 func (r *RCExpectations) CreationObserved(rc *api.ReplicationController) {
-	r.lowerExpectations(rc, 1, 0)
+	r.lowerExpectations(rc, 1, 0, 0, 0)
 }
 
 // DeletionObserved atomically decrements the `del` expectation count of the given replication controller.
+// It should only be called once a pod is genuinely gone (ie a delete watch event), never when a
+// drain merely begins.
 func (r *RCExpectations) DeletionObserved(rc *api.ReplicationController) {
-	r.lowerExpectations(rc, 0, 1)
+	r.lowerExpectations(rc, 0, 1, 0, 0)
+}
+
+// DrainObserved atomically decrements the `drain` expectation count of the given replication
+// controller, once a pod it marked with PodOperatingAnnotation has had its readiness gates
+// satisfied (or its grace period expire) and is clear for deletePod to be called.
+func (r *RCExpectations) DrainObserved(rc *api.ReplicationController) {
+	r.lowerExpectations(rc, 0, 0, 1, 0)
+}
+
+// UpdateObserved atomically decrements the `update` expectation count of the given
+// replication controller once the watch reflects a pod updateReplica patched in place.
+func (r *RCExpectations) UpdateObserved(rc *api.ReplicationController) {
+	r.lowerExpectations(rc, 0, 0, 0, 1)
 }
 
 // Expectations are either fulfilled, or expire naturally.
@@ -142,23 +385,30 @@ type Expectations interface {
 	Fulfilled() bool
 }
 
-// PodExpectations track pod creates/deletes.
+// PodExpectations track pod creates/deletes, pods pending a gated lifecycle
+// operation (see PodOperatingAnnotation) that haven't yet cleared their readiness
+// gates, and pods patched in place by updateReplica whose watch event is pending.
 type PodExpectations struct {
-	add int64
-	del int64
-	key string
+	add    int64
+	del    int64
+	drain  int64
+	update int64
+	key    string
 }
 
-// Seen decrements the add and del counters.
-func (e *PodExpectations) Seen(add, del int64) {
+// Seen decrements the add, del, drain, and update counters.
+func (e *PodExpectations) Seen(add, del, drain, update int64) {
 	atomic.AddInt64(&e.add, -add)
 	atomic.AddInt64(&e.del, -del)
+	atomic.AddInt64(&e.drain, -drain)
+	atomic.AddInt64(&e.update, -update)
 }
 
 // Fulfilled returns true if this expectation has been fulfilled.
 func (e *PodExpectations) Fulfilled() bool {
 	// TODO: think about why this line being atomic doesn't matter
-	return atomic.LoadInt64(&e.add) <= 0 && atomic.LoadInt64(&e.del) <= 0
+	return atomic.LoadInt64(&e.add) <= 0 && atomic.LoadInt64(&e.del) <= 0 &&
+		atomic.LoadInt64(&e.drain) <= 0 && atomic.LoadInt64(&e.update) <= 0
 }
 
 // NewRCExpectations returns a store for PodExpectations.
@@ -166,11 +416,183 @@ func NewRCExpectations() *RCExpectations {
 	return &RCExpectations{cache.NewTTLStore(expKeyFunc, ExpectationsTimeout)}
 }
 
+// readinessExpectation tracks how many of an rc's newly created pods are still
+// waiting to report ready, and the deadline after which the rc manager gives up
+// waiting and proceeds anyway (the timeout passed to ExpectCreationsReady or
+// ExpectDeletionsDrained).
+type readinessExpectation struct {
+	pending  int64
+	deadline time.Time
+	// observed tracks which pods have already counted against pending, so a
+	// pod isn't decremented twice by repeated watch events for the same pod.
+	observed map[string]bool
+}
+
+// ReadinessExpectations layers readiness gating on top of RCExpectations: unlike
+// PodExpectations.Fulfilled, which is satisfied as soon as a create/delete watch
+// event is observed, an rc's readiness expectations aren't fulfilled until the
+// pods of its most recent batch have either reported PodReady=true (a create
+// batch) or fully drained from the endpoints of every Service selecting them (a
+// delete batch). Only one batch is tracked per rc: each ExpectCreationsReady or
+// ExpectDeletionsDrained call replaces whatever batch was outstanding.
+// This lets rolling updates pause between batches until the previous batch is
+// confirmed healthy (or drained) rather than racing ahead of it.
+type ReadinessExpectations struct {
+	lock      sync.Mutex
+	pending   map[string]*readinessExpectation
+	endpoints client.EndpointsNamespacer
+}
+
+// NewReadinessExpectations returns a ReadinessExpectations that consults endpoints
+// to confirm deleted pods have fully drained from any Service selecting them.
+func NewReadinessExpectations(endpoints client.EndpointsNamespacer) *ReadinessExpectations {
+	return &ReadinessExpectations{pending: make(map[string]*readinessExpectation), endpoints: endpoints}
+}
+
+// ExpectCreationsReady records that n pods were just created for rc and should
+// each report ready (see ReadinessObserved) before rc's readiness expectations are
+// satisfied. If timeout elapses first, Satisfied proceeds without waiting further.
+func (e *ReadinessExpectations) ExpectCreationsReady(rc *api.ReplicationController, n int, timeout time.Duration) error {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.pending[rcKey] = &readinessExpectation{pending: int64(n), deadline: time.Now().Add(timeout), observed: make(map[string]bool)}
+	return nil
+}
+
+// ReadinessObserved decrements rc's pending readiness count once pod reports
+// PodReady=true, or its deadline has elapsed, whichever comes first. Repeated
+// calls for the same pod - eg successive watch events after it's already ready -
+// only count once.
+func (e *ReadinessExpectations) ReadinessObserved(rc *api.ReplicationController, pod *api.Pod) {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	exp, exists := e.pending[rcKey]
+	if !exists {
+		return
+	}
+	if !api.IsPodReady(pod) && time.Now().Before(exp.deadline) {
+		return
+	}
+	if exp.observed[pod.Name] {
+		return
+	}
+	exp.observed[pod.Name] = true
+	exp.pending--
+}
+
+// Satisfied returns true once every pod ExpectCreationsReady counted has either
+// reported ready or had its deadline elapse. An rc with no outstanding
+// ExpectCreationsReady call is trivially satisfied.
+func (e *ReadinessExpectations) Satisfied(rc *api.ReplicationController) bool {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return true
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	exp, exists := e.pending[rcKey]
+	if !exists {
+		return true
+	}
+	if exp.pending <= 0 || time.Now().After(exp.deadline) {
+		delete(e.pending, rcKey)
+		return true
+	}
+	return false
+}
+
+// ExpectDeletionsDrained behaves like ExpectCreationsReady, but for a batch of n
+// pods the rc manager just deleted: ConfirmDrainedFromEndpoints, not a delete
+// watch event, is what counts each one against pending. If timeout elapses
+// first, Satisfied proceeds without waiting further.
+func (e *ReadinessExpectations) ExpectDeletionsDrained(rc *api.ReplicationController, n int, timeout time.Duration) error {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.pending[rcKey] = &readinessExpectation{pending: int64(n), deadline: time.Now().Add(timeout), observed: make(map[string]bool)}
+	return nil
+}
+
+// ConfirmDrainedFromEndpoints decrements rc's pending count once pod has fully
+// drained from the endpoints of every Service in namespace selecting it, or rc's
+// deadline has elapsed, whichever comes first. Repeated calls for the same pod
+// only count once.
+func (e *ReadinessExpectations) ConfirmDrainedFromEndpoints(rc *api.ReplicationController, namespace string, pod *api.Pod) error {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		return err
+	}
+	e.lock.Lock()
+	exp, exists := e.pending[rcKey]
+	if !exists {
+		e.lock.Unlock()
+		return nil
+	}
+	deadline := exp.deadline
+	e.lock.Unlock()
+	if time.Now().Before(deadline) {
+		drained, err := e.podDrainedFromEndpoints(namespace, pod)
+		if err != nil {
+			return err
+		}
+		if !drained {
+			return nil
+		}
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if exp.observed[pod.Name] {
+		return nil
+	}
+	exp.observed[pod.Name] = true
+	exp.pending--
+	return nil
+}
+
+// podDrainedFromEndpoints returns true once pod's IP no longer appears in the
+// endpoints of any Service in namespace, ie it's safe to consider a deleted pod's
+// readiness-side expectations fully drained rather than merely watch-observed.
+func (e *ReadinessExpectations) podDrainedFromEndpoints(namespace string, pod *api.Pod) (bool, error) {
+	list, err := e.endpoints.Endpoints(namespace).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	for i := range list.Items {
+		for _, subset := range list.Items[i].Subsets {
+			for _, addr := range subset.Addresses {
+				if addr.IP == pod.Status.PodIP {
+					return false, nil
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
 // PodControlInterface is an interface that knows how to add or delete pods
 // created as an interface to allow testing.
 type PodControlInterface interface {
 	// createReplica creates new replicated pods according to the spec.
 	createReplica(namespace string, controller *api.ReplicationController) error
+	// beginPodDrain annotates the pod identified by podID with PodOperatingAnnotation
+	// so registered readiness gates know to hold off deletion, and returns the
+	// operation ID recorded alongside it.
+	beginPodDrain(namespace string, podID string, operation string) (string, error)
+	// updateReplica reconciles pod with controller's current template in place,
+	// rather than deleting and recreating it. Returns errImmutableFieldsChanged if
+	// the template diverges in fields that can't be patched in place.
+	updateReplica(namespace string, pod *api.Pod, controller *api.ReplicationController) error
 	// deletePod deletes the pod identified by podID.
 	deletePod(namespace string, podID string) error
 }
@@ -179,6 +601,9 @@ type PodControlInterface interface {
 type RealPodControl struct {
 	kubeClient client.Interface
 	recorder   record.EventRecorder
+	// slotAllocator assigns each replica a stable, deterministic name instead of
+	// relying on GenerateName, so restarts don't churn names external systems rely on.
+	slotAllocator *ReplicaSlotAllocator
 }
 
 func (r RealPodControl) createReplica(namespace string, controller *api.ReplicationController) error {
@@ -203,39 +628,472 @@ func (r RealPodControl) createReplica(namespace string, controller *api.Replicat
 
 	desiredAnnotations[CreatedByAnnotation] = string(createdByRefJson)
 
-	// use the dash (if the name isn't too long) to make the pod name a bit prettier
-	prefix := fmt.Sprintf("%s-", controller.Name)
-	if ok, _ := validation.ValidatePodName(prefix, true); !ok {
-		prefix = controller.Name
+	slot, err := r.slotAllocator.NextSlot(controller)
+	if err != nil {
+		return fmt.Errorf("unable to allocate a replica slot: %v", err)
+	}
+	name := replicaSlotName(controller, slot)
+	if ok, _ := validation.ValidatePodName(name, false); !ok {
+		r.slotAllocator.ReleaseSlotID(controller, slot)
+		return fmt.Errorf("unable to create pod replica, invalid slot name %q", name)
 	}
+	desiredAnnotations[ReplicaInstanceIDAnnotation] = name
+	desiredAnnotations[PodTemplateHashAnnotation] = podTemplateHash(controller)
 
 	pod := &api.Pod{
 		ObjectMeta: api.ObjectMeta{
-			Labels:       desiredLabels,
-			Annotations:  desiredAnnotations,
-			GenerateName: prefix,
+			Name:        name,
+			Labels:      desiredLabels,
+			Annotations: desiredAnnotations,
 		},
 	}
 	if err := api.Scheme.Convert(&controller.Spec.Template.Spec, &pod.Spec); err != nil {
+		r.slotAllocator.ReleaseSlotID(controller, slot)
 		return fmt.Errorf("unable to convert pod template: %v", err)
 	}
 	if labels.Set(pod.Labels).AsSelector().Empty() {
+		r.slotAllocator.ReleaseSlotID(controller, slot)
 		return fmt.Errorf("unable to create pod replica, no labels")
 	}
 	if newPod, err := r.kubeClient.Pods(namespace).Create(pod); err != nil {
+		r.slotAllocator.ReleaseSlotID(controller, slot)
 		r.recorder.Eventf(controller, "failedCreate", "Error creating: %v", err)
 		return fmt.Errorf("unable to create pod replica: %v", err)
 	} else {
+		r.slotAllocator.Reserve(controller, slot, newPod.Name)
 		glog.V(4).Infof("Controller %v created pod %v", controller.Name, newPod.Name)
 		r.recorder.Eventf(controller, "successfulCreate", "Created pod: %v", newPod.Name)
 	}
 	return nil
 }
 
+// updateReplica reconciles pod with controller's current Spec.Template in place
+// when the diff is limited to mutable fields (container images, resources, env,
+// labels/annotations). Container resources, requests and limits alike, are copied
+// from the template as given; their values are not bounds-checked. If the diff touches immutable
+// fields it records a failedInPlaceUpdate event and returns errImmutableFieldsChanged
+// so the caller can fall through to delete+create.
+func (r RealPodControl) updateReplica(namespace string, pod *api.Pod, controller *api.ReplicationController) error {
+	if !canUpdateInPlace(pod, &controller.Spec.Template.Spec) {
+		r.recorder.Eventf(controller, "failedInPlaceUpdate", "Pod %v has changes to immutable fields, falling back to delete+create", pod.Name)
+		return errImmutableFieldsChanged
+	}
+	objCopy, err := api.Scheme.Copy(pod)
+	if err != nil {
+		return fmt.Errorf("unable to copy pod %v: %v", pod.Name, err)
+	}
+	updated := objCopy.(*api.Pod)
+
+	for i := range updated.Spec.Containers {
+		desired := controller.Spec.Template.Spec.Containers[i]
+		updated.Spec.Containers[i].Image = desired.Image
+		updated.Spec.Containers[i].Resources = desired.Resources
+		updated.Spec.Containers[i].Env = desired.Env
+	}
+	if updated.Labels == nil {
+		updated.Labels = make(labels.Set)
+	}
+	if updated.Annotations == nil {
+		updated.Annotations = make(labels.Set)
+	}
+	for k, v := range controller.Spec.Template.Labels {
+		updated.Labels[k] = v
+	}
+	for k, v := range controller.Spec.Template.Annotations {
+		updated.Annotations[k] = v
+	}
+	updated.Annotations[PodTemplateHashAnnotation] = podTemplateHash(controller)
+
+	if _, err := r.kubeClient.Pods(namespace).Update(updated); err != nil {
+		r.recorder.Eventf(controller, "failedInPlaceUpdate", "Error updating pod %v in place: %v", pod.Name, err)
+		return fmt.Errorf("unable to update pod %v in place: %v", pod.Name, err)
+	}
+	glog.V(4).Infof("Controller %v updated pod %v in place", controller.Name, pod.Name)
+	r.recorder.Eventf(controller, "successfulUpdate", "Updated pod %v in place", pod.Name)
+	return nil
+}
+
+// canUpdateInPlace returns true if pod can be reconciled with template without
+// deleting and recreating it, ie the two only differ in mutable fields.
+func canUpdateInPlace(pod *api.Pod, template *api.PodSpec) bool {
+	if len(pod.Spec.Containers) != len(template.Containers) {
+		return false
+	}
+	for i, c := range pod.Spec.Containers {
+		if c.Name != template.Containers[i].Name {
+			return false
+		}
+		if len(c.Ports) != len(template.Containers[i].Ports) {
+			return false
+		}
+		for j, p := range c.Ports {
+			if p != template.Containers[i].Ports[j] {
+				return false
+			}
+		}
+	}
+	if len(pod.Spec.Volumes) != len(template.Volumes) {
+		return false
+	}
+	for i, v := range pod.Spec.Volumes {
+		if v != template.Volumes[i] {
+			return false
+		}
+	}
+	if pod.Spec.RestartPolicy != template.RestartPolicy {
+		return false
+	}
+	if !nodeSelectorsEqual(pod.Spec.NodeSelector, template.NodeSelector) {
+		return false
+	}
+	return true
+}
+
+// nodeSelectorsEqual reports whether a and b contain exactly the same key/value
+// pairs; nil and empty are equal. NodeSelector is immutable once a pod is
+// created, so any difference here means canUpdateInPlace must fall back to
+// delete+create regardless of whether the pod has been scheduled yet.
+func nodeSelectorsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// podTemplateHash computes a stable hash of controller's pod template, recorded on
+// every pod via PodTemplateHashAnnotation to detect template drift.
+func podTemplateHash(controller *api.ReplicationController) string {
+	hasher := fnv.New32a()
+	fmt.Fprintf(hasher, "%#v", controller.Spec.Template)
+	return fmt.Sprintf("%d", hasher.Sum32())
+}
+
 func (r RealPodControl) deletePod(namespace, podID string) error {
 	return r.kubeClient.Pods(namespace).Delete(podID)
 }
 
+// beginPodDrain marks podID as undergoing the named lifecycle operation by setting
+// PodOperatingAnnotation and a freshly minted PodOperationIDAnnotation. Callers
+// should not invoke deletePod on the pod until isPodDrainComplete reports true, or
+// the rc's scaleDownGracePeriodSeconds elapses.
+func (r RealPodControl) beginPodDrain(namespace, podID, operation string) (string, error) {
+	pod, err := r.kubeClient.Pods(namespace).Get(podID)
+	if err != nil {
+		return "", fmt.Errorf("unable to get pod %v to begin drain: %v", podID, err)
+	}
+	operationID := string(util.NewUUID())
+	if pod.Annotations == nil {
+		pod.Annotations = make(labels.Set)
+	}
+	pod.Annotations[PodOperatingAnnotation] = operation
+	pod.Annotations[PodOperationIDAnnotation] = operationID
+	pod.Annotations[PodOperationStartedAnnotation] = time.Now().Format(time.RFC3339)
+	if _, err := r.kubeClient.Pods(namespace).Update(pod); err != nil {
+		return "", fmt.Errorf("unable to mark pod %v for %v: %v", podID, operation, err)
+	}
+	return operationID, nil
+}
+
+// isPodDrainComplete returns true once pod's PodOperateReadyAnnotation holds the
+// ID of its pending PodOperatingAnnotation operation, or the rc's grace period for
+// that operation has run out. A single ack is enough: acks from individual
+// readiness gates are not tracked separately. A pod with no pending operation
+// counts as complete.
+func isPodDrainComplete(pod *api.Pod, gracePeriod time.Duration) bool {
+	operationID, ok := pod.Annotations[PodOperationIDAnnotation]
+	if !ok {
+		return true
+	}
+	if pod.Annotations[PodOperateReadyAnnotation] == operationID {
+		return true
+	}
+	startedAt, err := time.Parse(time.RFC3339, pod.Annotations[PodOperationStartedAnnotation])
+	if err != nil {
+		return false
+	}
+	return time.Now().After(startedAt.Add(gracePeriod))
+}
+
+// scaleDownGracePeriod returns the grace period an rc allows for readiness gates to
+// ack a pending scale-down drain before the rc manager deletes the pod regardless.
+func scaleDownGracePeriod(controller *api.ReplicationController) time.Duration {
+	if controller.Spec.ScaleDownGracePeriodSeconds != nil {
+		return time.Duration(*controller.Spec.ScaleDownGracePeriodSeconds) * time.Second
+	}
+	return DefaultScaleDownGracePeriod
+}
+
+// DeleteReplica deletes pod on behalf of controller, the rc manager sync loop's
+// entry point for removing a replica. For rcs with Spec.PodLifecycleGate unset
+// this deletes immediately, today's only pre-existing behavior. For gated rcs it
+// begins a drain on the first call, then holds off the actual delete - rechecking
+// on each subsequent sync - until isPodDrainComplete reports true or the rc's
+// scaleDownGracePeriod elapses.
+func DeleteReplica(podControl PodControlInterface, rcExp *RCExpectations, namespace string, controller *api.ReplicationController, pod *api.Pod) error {
+	if !controller.Spec.PodLifecycleGate {
+		return podControl.deletePod(namespace, pod.Name)
+	}
+	if pod.Annotations[PodOperatingAnnotation] != PodOperationScaleDown {
+		if _, err := podControl.beginPodDrain(namespace, pod.Name, PodOperationScaleDown); err != nil {
+			return err
+		}
+		return rcExp.ExpectDrains(controller, 1)
+	}
+	if !isPodDrainComplete(pod, scaleDownGracePeriod(controller)) {
+		return nil
+	}
+	rcExp.DrainObserved(controller)
+	return podControl.deletePod(namespace, pod.Name)
+}
+
+// reconcilePod reconciles pod with controller's current template according to
+// controller.Spec.UpdateStrategy, defaulting to api.RecreateRCUpdateStrategy -
+// delete+create via DeleteReplica, today's only pre-existing behavior - when
+// unset. InPlaceIfPossible falls back to DeleteReplica when updateReplica
+// returns errImmutableFieldsChanged; InPlaceOnly returns that error to the caller
+// instead.
+func reconcilePod(podControl PodControlInterface, rcExp *RCExpectations, namespace string, pod *api.Pod, controller *api.ReplicationController) error {
+	strategy := controller.Spec.UpdateStrategy
+	if strategy == "" {
+		strategy = api.RecreateRCUpdateStrategy
+	}
+	if strategy == api.RecreateRCUpdateStrategy {
+		return DeleteReplica(podControl, rcExp, namespace, controller, pod)
+	}
+	err := podControl.updateReplica(namespace, pod, controller)
+	if err == nil {
+		return rcExp.ExpectUpdates(controller, 1)
+	}
+	if err != errImmutableFieldsChanged || strategy == api.InPlaceOnlyRCUpdateStrategy {
+		return err
+	}
+	return DeleteReplica(podControl, rcExp, namespace, controller, pod)
+}
+
+// DeletionPolicy selects and orders an rc's active pods so the candidates deleted
+// first when scaling in are whichever the policy considers least valuable to keep.
+// Implementations are registered by name so Spec.ScaleInPolicy can be validated at
+// admission time; see RegisterDeletionPolicy and IsRegisteredDeletionPolicy.
+type DeletionPolicy interface {
+	// Sort returns pods reordered so earlier entries should be deleted first. It
+	// must not mutate pods.
+	Sort(pods []*api.Pod) []*api.Pod
+}
+
+// deletionPolicies holds the built-in DeletionPolicy implementations, keyed by the
+// Spec.ScaleInPolicy name that selects them.
+var deletionPolicies = map[string]DeletionPolicy{
+	LeastReadyDeletionPolicy:   leastReadyDeletionPolicy{},
+	NewestDeletionPolicy:       newestDeletionPolicy{},
+	OldestDeletionPolicy:       oldestDeletionPolicy{},
+	LabeledFirstDeletionPolicy: labeledFirstDeletionPolicy{},
+	CostAwareDeletionPolicy:    costAwareDeletionPolicy{},
+	SpreadAwareDeletionPolicy:  spreadAwareDeletionPolicy{},
+}
+
+// RegisterDeletionPolicy adds policy under name, so it becomes a valid value for
+// Spec.ScaleInPolicy. Intended for out-of-tree policies; the built-ins above are
+// registered unconditionally.
+func RegisterDeletionPolicy(name string, policy DeletionPolicy) {
+	deletionPolicies[name] = policy
+}
+
+// IsRegisteredDeletionPolicy reports whether name names a registered
+// DeletionPolicy, for admission-time validation of Spec.ScaleInPolicy.
+func IsRegisteredDeletionPolicy(name string) bool {
+	_, ok := deletionPolicies[name]
+	return ok
+}
+
+// ValidateScaleInPolicy checks that controller.Spec.ScaleInPolicy, if set, names
+// a registered DeletionPolicy. Intended to be called from the rc admission path
+// alongside the rest of ValidateReplicationController.
+func ValidateScaleInPolicy(controller *api.ReplicationController) error {
+	if controller.Spec.ScaleInPolicy == "" {
+		return nil
+	}
+	if !IsRegisteredDeletionPolicy(controller.Spec.ScaleInPolicy) {
+		return fmt.Errorf("unregistered ScaleInPolicy %q", controller.Spec.ScaleInPolicy)
+	}
+	return nil
+}
+
+// deletionPolicyFor returns the DeletionPolicy controller selected via
+// Spec.ScaleInPolicy, falling back to LeastReadyDeletionPolicy - today's default
+// behavior - if it's unset or names a policy that was never registered.
+func deletionPolicyFor(controller *api.ReplicationController) DeletionPolicy {
+	if controller.Spec.ScaleInPolicy == "" {
+		return deletionPolicies[LeastReadyDeletionPolicy]
+	}
+	if err := ValidateScaleInPolicy(controller); err != nil {
+		glog.V(2).Infof("Controller %v: %v, falling back to %v", controller.Name, err, LeastReadyDeletionPolicy)
+		return deletionPolicies[LeastReadyDeletionPolicy]
+	}
+	return deletionPolicies[controller.Spec.ScaleInPolicy]
+}
+
+// podsToDelete returns up to count of active's pods, chosen and ordered by
+// controller's DeletionPolicy, for the rc manager to pass to deletePod.
+func podsToDelete(controller *api.ReplicationController, active []*api.Pod, count int) []*api.Pod {
+	sorted := deletionPolicyFor(controller).Sort(active)
+	if count > len(sorted) {
+		count = len(sorted)
+	}
+	return sorted[:count]
+}
+
+// ScaleIn deletes count of active's pods, chosen and ordered by controller's
+// DeletionPolicy, via podControl. Pods are removed through DeleteReplica so rcs
+// with Spec.PodLifecycleGate set drain before being deleted.
+func ScaleIn(podControl PodControlInterface, rcExp *RCExpectations, namespace string, controller *api.ReplicationController, active []*api.Pod, count int) error {
+	for _, pod := range podsToDelete(controller, active, count) {
+		if err := DeleteReplica(podControl, rcExp, namespace, controller, pod); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// leastReadyDeletionPolicy is today's default: prefer deleting unassigned, earlier-
+// phase, not-yet-ready, or already-draining pods. See activePods.Less.
+type leastReadyDeletionPolicy struct{}
+
+func (leastReadyDeletionPolicy) Sort(pods []*api.Pod) []*api.Pod {
+	sorted := append([]*api.Pod(nil), pods...)
+	sort.Sort(activePods(sorted))
+	return sorted
+}
+
+// byCreationTime sorts pods by CreationTimestamp, newest or oldest first.
+type byCreationTime struct {
+	pods        []*api.Pod
+	newestFirst bool
+}
+
+func (s byCreationTime) Len() int      { return len(s.pods) }
+func (s byCreationTime) Swap(i, j int) { s.pods[i], s.pods[j] = s.pods[j], s.pods[i] }
+func (s byCreationTime) Less(i, j int) bool {
+	if s.newestFirst {
+		return s.pods[j].CreationTimestamp.Before(s.pods[i].CreationTimestamp)
+	}
+	return s.pods[i].CreationTimestamp.Before(s.pods[j].CreationTimestamp)
+}
+
+// newestDeletionPolicy deletes the most recently created pods first.
+type newestDeletionPolicy struct{}
+
+func (newestDeletionPolicy) Sort(pods []*api.Pod) []*api.Pod {
+	sorted := append([]*api.Pod(nil), pods...)
+	sort.Sort(byCreationTime{pods: sorted, newestFirst: true})
+	return sorted
+}
+
+// oldestDeletionPolicy deletes the longest-running pods first.
+type oldestDeletionPolicy struct{}
+
+func (oldestDeletionPolicy) Sort(pods []*api.Pod) []*api.Pod {
+	sorted := append([]*api.Pod(nil), pods...)
+	sort.Sort(byCreationTime{pods: sorted})
+	return sorted
+}
+
+// labeledFirstPods sorts pods carrying ToBeDeletedLabel=true before any others,
+// falling back to leastReady ordering among pods that agree.
+type labeledFirstPods []*api.Pod
+
+func (s labeledFirstPods) Len() int      { return len(s) }
+func (s labeledFirstPods) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s labeledFirstPods) Less(i, j int) bool {
+	iMarked := s[i].Annotations[ToBeDeletedLabel] == "true"
+	jMarked := s[j].Annotations[ToBeDeletedLabel] == "true"
+	if iMarked != jMarked {
+		return iMarked
+	}
+	return activePods(s).Less(i, j)
+}
+
+// labeledFirstDeletionPolicy deletes pods carrying ToBeDeletedLabel=true before any
+// others, for callers that want to pick specific pods for removal out of band.
+type labeledFirstDeletionPolicy struct{}
+
+func (labeledFirstDeletionPolicy) Sort(pods []*api.Pod) []*api.Pod {
+	sorted := append([]*api.Pod(nil), pods...)
+	sort.Sort(labeledFirstPods(sorted))
+	return sorted
+}
+
+// podDeletionCost parses pod's PodDeletionCostAnnotation, defaulting to 0 (and
+// thus to being deleted ahead of any pod with a positive cost) for pods that
+// don't set it or set it to a non-integer.
+func podDeletionCost(pod *api.Pod) int64 {
+	cost, err := strconv.ParseInt(pod.Annotations[PodDeletionCostAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return cost
+}
+
+// costAwarePods sorts by ascending PodDeletionCostAnnotation, falling back to
+// leastReady ordering among pods with equal (or unset) cost.
+type costAwarePods []*api.Pod
+
+func (s costAwarePods) Len() int      { return len(s) }
+func (s costAwarePods) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s costAwarePods) Less(i, j int) bool {
+	iCost, jCost := podDeletionCost(s[i]), podDeletionCost(s[j])
+	if iCost != jCost {
+		return iCost < jCost
+	}
+	return activePods(s).Less(i, j)
+}
+
+// costAwareDeletionPolicy respects controller.kubernetes.io/pod-deletion-cost,
+// deleting lowest-cost pods first.
+type costAwareDeletionPolicy struct{}
+
+func (costAwareDeletionPolicy) Sort(pods []*api.Pod) []*api.Pod {
+	sorted := append([]*api.Pod(nil), pods...)
+	sort.Sort(costAwarePods(sorted))
+	return sorted
+}
+
+// spreadAwarePods sorts pods by descending population of their own node - ie pods
+// on the most crowded node sort first - falling back to leastReady ordering within
+// a node.
+type spreadAwarePods struct {
+	pods      []*api.Pod
+	nodeCount map[string]int
+}
+
+func (s spreadAwarePods) Len() int      { return len(s.pods) }
+func (s spreadAwarePods) Swap(i, j int) { s.pods[i], s.pods[j] = s.pods[j], s.pods[i] }
+func (s spreadAwarePods) Less(i, j int) bool {
+	iCount, jCount := s.nodeCount[s.pods[i].Spec.Host], s.nodeCount[s.pods[j].Spec.Host]
+	if iCount != jCount {
+		return iCount > jCount
+	}
+	return activePods(s.pods).Less(i, j)
+}
+
+// spreadAwareDeletionPolicy prefers deleting pods from the node with the most
+// replicas of this rc, to even out the spread after scaling in.
+type spreadAwareDeletionPolicy struct{}
+
+func (spreadAwareDeletionPolicy) Sort(pods []*api.Pod) []*api.Pod {
+	sorted := append([]*api.Pod(nil), pods...)
+	nodeCount := make(map[string]int, len(pods))
+	for _, pod := range pods {
+		nodeCount[pod.Spec.Host]++
+	}
+	sort.Sort(spreadAwarePods{pods: sorted, nodeCount: nodeCount})
+	return sorted
+}
+
 // activePods type allows custom sorting of pods so an rc can pick the best ones to delete.
 type activePods []*api.Pod
 
@@ -256,9 +1114,26 @@ func (s activePods) Less(i, j int) bool {
 	if !api.IsPodReady(s[i]) && api.IsPodReady(s[j]) {
 		return true
 	}
+	// Among pods that are otherwise tied, a pod already marked with
+	// PodOperatingAnnotation, whether or not its drain has completed, sorts first
+	// so the rc manager finishes in-flight scale-downs before it marks any further
+	// pods as draining.
+	iDraining := s[i].Annotations[PodOperatingAnnotation] != ""
+	jDraining := s[j].Annotations[PodOperatingAnnotation] != ""
+	if iDraining && !jDraining {
+		return true
+	}
 	return false
 }
 
+// reconcileReplicaSlots adopts any of active's pods that predate slotAllocator -
+// ie still named via the legacy GenerateName scheme - into a free slot. The rc
+// manager's sync loop should call this once per sync, the slot-allocator analog
+// of updateReplicaCount, before deciding how many replicas to create or delete.
+func reconcileReplicaSlots(slotAllocator *ReplicaSlotAllocator, kubeClient client.Interface, namespace string, controller *api.ReplicationController, active []*api.Pod) error {
+	return slotAllocator.AdoptExisting(kubeClient, namespace, controller, active)
+}
+
 // filterActivePods returns pods that have not terminated.
 func filterActivePods(pods []api.Pod) []*api.Pod {
 	var result []*api.Pod
@@ -289,3 +1164,39 @@ func updateReplicaCount(rcClient client.ReplicationControllerInterface, controll
 		return true, nil
 	})
 }
+
+// SyncReadyReplicas recomputes controller's ready replica count from active and
+// persists it via updateReadyReplicaCount. The rc manager's sync loop should
+// call this alongside updateReplicaCount whenever active's readiness may have
+// changed, so Status.ReadyReplicas reflects pods that have actually passed
+// their readiness probe rather than merely been created.
+func SyncReadyReplicas(rcClient client.ReplicationControllerInterface, controller *api.ReplicationController, active []*api.Pod) error {
+	ready := 0
+	for _, pod := range active {
+		if api.IsPodReady(pod) {
+			ready++
+		}
+	}
+	return updateReadyReplicaCount(rcClient, controller, ready)
+}
+
+// updateReadyReplicaCount attempts to update the Status.ReadyReplicas of the given
+// controller, with retries, mirroring updateReplicaCount. The rc manager calls this
+// as ReadinessExpectations reports pods ready, so Status.ReadyReplicas reflects pods
+// that have actually passed their readiness probe rather than merely been created.
+func updateReadyReplicaCount(rcClient client.ReplicationControllerInterface, controller *api.ReplicationController, numReady int) error {
+	return wait.Poll(10*time.Millisecond, 100*time.Millisecond, func() (bool, error) {
+		if controller.Status.ReadyReplicas != numReady {
+			glog.V(4).Infof("Updating ready replica count for rc: %v, %d->%d", controller.Name, controller.Status.ReadyReplicas, numReady)
+			controller.Status.ReadyReplicas = numReady
+			_, err := rcClient.Update(controller)
+			if err != nil {
+				glog.V(2).Infof("Controller %v failed to update ready replica count: %v", controller.Name, err)
+				// Update the controller with the latest resource version for the next poll
+				controller, _ = rcClient.Get(controller.Name)
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func newTestPod(name string) *api.Pod {
+	return &api.Pod{ObjectMeta: api.ObjectMeta{Name: name}}
+}
+
+// TestLeastReadyDeletionPolicySortTies verifies that pods which are otherwise
+// indistinguishable to activePods.Less (same phase, readiness, host, and drain
+// state) still all come back out of Sort, rather than being dropped or
+// panicking on the comparisons that find them equal.
+func TestLeastReadyDeletionPolicySortTies(t *testing.T) {
+	pods := []*api.Pod{newTestPod("a"), newTestPod("b"), newTestPod("c")}
+	sorted := leastReadyDeletionPolicy{}.Sort(pods)
+	if len(sorted) != len(pods) {
+		t.Fatalf("got %d pods, want %d", len(sorted), len(pods))
+	}
+	seen := map[string]bool{}
+	for _, pod := range sorted {
+		seen[pod.Name] = true
+	}
+	for _, pod := range pods {
+		if !seen[pod.Name] {
+			t.Errorf("pod %v missing from sorted output", pod.Name)
+		}
+	}
+}
+
+// TestLabeledFirstDeletionPolicyEmptyLabels verifies labeledFirstDeletionPolicy
+// doesn't panic on pods with nil Labels/Annotations maps, and still sorts a pod
+// explicitly marked with the ToBeDeletedLabel annotation ahead of unmarked ones.
+func TestLabeledFirstDeletionPolicyEmptyLabels(t *testing.T) {
+	unlabeled := newTestPod("unlabeled")
+	marked := newTestPod("marked")
+	marked.Annotations = map[string]string{ToBeDeletedLabel: "true"}
+
+	sorted := labeledFirstDeletionPolicy{}.Sort([]*api.Pod{unlabeled, marked})
+	if len(sorted) != 2 {
+		t.Fatalf("got %d pods, want 2", len(sorted))
+	}
+	if sorted[0].Name != "marked" {
+		t.Errorf("expected marked pod first, got %v", sorted[0].Name)
+	}
+}
+
+// TestPodsToDeleteCountClamp verifies podsToDelete clamps count to the number
+// of active pods instead of slicing out of range.
+func TestPodsToDeleteCountClamp(t *testing.T) {
+	controller := &api.ReplicationController{}
+	active := []*api.Pod{newTestPod("a"), newTestPod("b")}
+	got := podsToDelete(controller, active, 5)
+	if len(got) != len(active) {
+		t.Errorf("got %d pods, want %d", len(got), len(active))
+	}
+}
+
+// TestValidateScaleInPolicy checks the empty, registered, and unregistered cases.
+func TestValidateScaleInPolicy(t *testing.T) {
+	cases := []struct {
+		policy  string
+		wantErr bool
+	}{
+		{"", false},
+		{LeastReadyDeletionPolicy, false},
+		{"NotRegistered", true},
+	}
+	for _, c := range cases {
+		controller := &api.ReplicationController{Spec: api.ReplicationControllerSpec{ScaleInPolicy: c.policy}}
+		err := ValidateScaleInPolicy(controller)
+		if (err != nil) != c.wantErr {
+			t.Errorf("ValidateScaleInPolicy(%q) error = %v, wantErr %v", c.policy, err, c.wantErr)
+		}
+	}
+}